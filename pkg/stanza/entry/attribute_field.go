@@ -0,0 +1,213 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entry // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// AttributeField represents a potential field on an entry's attributes
+type AttributeField struct {
+	Keys []string
+}
+
+// Parent returns the parent of the current field
+func (f AttributeField) Parent() AttributeField {
+	if f.isRoot() {
+		return f
+	}
+	keys := f.Keys[:len(f.Keys)-1]
+	return AttributeField{keys}
+}
+
+// Child returns a child of the current field
+func (f AttributeField) Child(key string) AttributeField {
+	child := make([]string, len(f.Keys), len(f.Keys)+1)
+	copy(child, f.Keys)
+	child = append(child, key)
+	return AttributeField{child}
+}
+
+// String returns the string representation of this field
+func (f AttributeField) String() string {
+	return toJSONDot(AttributeFieldPrefix, f.Keys)
+}
+
+// Get will return the specified value from the entry's attributes
+func (f AttributeField) Get(entry *Entry) (interface{}, bool) {
+	if entry.Attributes == nil {
+		return "", false
+	}
+
+	var currentValue interface{} = entry.Attributes
+
+	for _, key := range f.Keys {
+		currentMap, ok := currentValue.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		currentValue, ok = currentMap[key]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return currentValue, true
+}
+
+// Set will set the value on the entry's attributes using the field's key
+// path. If val is a map, it is merged into the existing value at that path
+// using the default MergeOptions (new keys win); see Merge for finer
+// control.
+func (f AttributeField) Set(entry *Entry, val interface{}) error {
+	if entry.Attributes == nil {
+		entry.Attributes = map[string]interface{}{}
+	}
+
+	if mapValue, ok := val.(map[string]interface{}); ok {
+		return f.Merge(entry, mapValue)
+	}
+
+	if f.isRoot() {
+		return fmt.Errorf("cannot set attributes to non-map type")
+	}
+
+	currentMap := entry.Attributes
+	for i, key := range f.Keys {
+		if i == len(f.Keys)-1 {
+			currentMap[key] = val
+			break
+		}
+		currentMap = f.getNestedMap(currentMap, key)
+	}
+	return nil
+}
+
+// Merge merges mapValues into the entry's attributes at the field's key
+// path. By default, keys in mapValues always win over whatever is already
+// present; pass MergeOptions to change that.
+func (f AttributeField) Merge(entry *Entry, mapValues map[string]interface{}, opts ...MergeOption) error {
+	currentMap := entry.Attributes
+	for _, key := range f.Keys {
+		currentMap = f.getNestedMap(currentMap, key)
+	}
+
+	cfg := newMergeConfig(opts...)
+	return mergeInto(currentMap, mapValues, cfg)
+}
+
+func (f AttributeField) getNestedMap(currentMap map[string]interface{}, key string) map[string]interface{} {
+	currentValue, ok := currentMap[key]
+	if !ok {
+		currentValue = map[string]interface{}{}
+		currentMap[key] = currentValue
+	}
+
+	nextMap, ok := currentValue.(map[string]interface{})
+	if !ok {
+		nextMap = map[string]interface{}{}
+		currentMap[key] = nextMap
+	}
+
+	return nextMap
+}
+
+// Delete removes a value from an entry's attributes using the field's key
+// path
+func (f AttributeField) Delete(entry *Entry) (interface{}, bool) {
+	if entry.Attributes == nil {
+		return "", false
+	}
+
+	if f.isRoot() {
+		oldAttributes := entry.Attributes
+		entry.Attributes = nil
+		return oldAttributes, true
+	}
+
+	currentMap := entry.Attributes
+	for i, key := range f.Keys {
+		currentValue, ok := currentMap[key]
+		if !ok {
+			break
+		}
+
+		if i == len(f.Keys)-1 {
+			delete(currentMap, key)
+			return currentValue, true
+		}
+
+		currentMap, ok = currentValue.(map[string]interface{})
+		if !ok {
+			break
+		}
+	}
+
+	return nil, false
+}
+
+// UnmarshalJSON will unmarshal a field from JSON
+func (f *AttributeField) UnmarshalJSON(raw []byte) error {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return fmt.Errorf("the field is not a string: %s", err)
+	}
+	field, err := newAttributeField(s)
+	if err != nil {
+		return err
+	}
+	*f = field
+	return nil
+}
+
+// UnmarshalYAML will unmarshal a field from YAML
+func (f *AttributeField) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return fmt.Errorf("the field is not a string: %s", err)
+	}
+	field, err := newAttributeField(s)
+	if err != nil {
+		return err
+	}
+	*f = field
+	return nil
+}
+
+// MarshalYAML will marshal a field for YAML
+func (f AttributeField) MarshalYAML() (interface{}, error) {
+	return f.String(), nil
+}
+
+// MarshalJSON will marshal a field for JSON
+func (f AttributeField) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.String())
+}
+
+func (f AttributeField) isRoot() bool {
+	return len(f.Keys) == 0
+}
+
+// NewAttributeField will create a new attribute field from a key
+func NewAttributeField(keys ...string) AttributeField {
+	return AttributeField{
+		Keys: keys,
+	}
+}
+
+func newAttributeField(s string) (AttributeField, error) {
+	keys, err := fromJSONDot(s)
+	if err != nil {
+		return AttributeField{}, err
+	}
+	if keys[0] != AttributeFieldPrefix {
+		return AttributeField{}, fmt.Errorf("must start with 'attributes': %s", s)
+	}
+	keys = keys[1:]
+	return AttributeField{
+		Keys: keys,
+	}, nil
+}
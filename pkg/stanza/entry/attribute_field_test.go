@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttributeFieldGetSetDelete(t *testing.T) {
+	entry := New()
+	field := NewAttributeField("nested", "key")
+
+	_, ok := field.Get(entry)
+	require.False(t, ok)
+
+	require.NoError(t, field.Set(entry, "value"))
+	val, ok := field.Get(entry)
+	require.True(t, ok)
+	require.Equal(t, "value", val)
+
+	deleted, ok := field.Delete(entry)
+	require.True(t, ok)
+	require.Equal(t, "value", deleted)
+}
+
+func TestAttributeFieldMergeOptions(t *testing.T) {
+	entry := New()
+	entry.Attributes = map[string]interface{}{"a": "old", "b": "keep"}
+
+	err := NewAttributeField().Merge(entry, map[string]interface{}{"a": "new"}, WithOverrideEmptyOnly())
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"a": "old", "b": "keep"}, entry.Attributes)
+
+	err = NewAttributeField().Merge(entry, map[string]interface{}{"a": "new"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"a": "new", "b": "keep"}, entry.Attributes)
+}
+
+func TestAttributeFieldUnmarshal(t *testing.T) {
+	var f AttributeField
+	require.NoError(t, f.UnmarshalJSON([]byte(`"attributes.nested.key"`)))
+	require.Equal(t, []string{"nested", "key"}, f.Keys)
+
+	var bad AttributeField
+	err := bad.UnmarshalJSON([]byte(`"resource.foo"`))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "must start with 'attributes'")
+}
@@ -0,0 +1,221 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entry // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// BodyField represents a potential field on an entry's body. Unlike
+// ResourceField and AttributeField, the root of a BodyField isn't
+// constrained to a map - the body can be any type.
+type BodyField struct {
+	Keys []string
+}
+
+// Parent returns the parent of the current field
+func (f BodyField) Parent() BodyField {
+	if f.isRoot() {
+		return f
+	}
+	keys := f.Keys[:len(f.Keys)-1]
+	return BodyField{keys}
+}
+
+// Child returns a child of the current field
+func (f BodyField) Child(key string) BodyField {
+	child := make([]string, len(f.Keys), len(f.Keys)+1)
+	copy(child, f.Keys)
+	child = append(child, key)
+	return BodyField{child}
+}
+
+// String returns the string representation of this field
+func (f BodyField) String() string {
+	return toJSONDot(BodyFieldPrefix, f.Keys)
+}
+
+// Get will return the specified value from the entry's body
+func (f BodyField) Get(entry *Entry) (interface{}, bool) {
+	currentValue := entry.Body
+
+	for _, key := range f.Keys {
+		currentMap, ok := currentValue.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		currentValue, ok = currentMap[key]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return currentValue, true
+}
+
+// Set will set the value on the entry's body using the field's key path. A
+// root field accepts any value, since the body isn't constrained to a map.
+// A non-root field requires the body (or the value at the field's parent)
+// to be, or become, a map. If val is a map, it is merged into the existing
+// value at that path using the default MergeOptions (new keys win); see
+// Merge for finer control.
+func (f BodyField) Set(entry *Entry, val interface{}) error {
+	if mapValue, ok := val.(map[string]interface{}); ok {
+		return f.Merge(entry, mapValue)
+	}
+
+	if f.isRoot() {
+		entry.Body = val
+		return nil
+	}
+
+	currentMap := f.rootMap(entry)
+	for i, key := range f.Keys {
+		if i == len(f.Keys)-1 {
+			currentMap[key] = val
+			break
+		}
+		currentMap = f.getNestedMap(currentMap, key)
+	}
+	return nil
+}
+
+// Merge merges mapValues into the entry's body at the field's key path,
+// initializing the body (or the value at that path) to a map if it isn't
+// one already. By default, keys in mapValues always win over whatever is
+// already present; pass MergeOptions to change that.
+func (f BodyField) Merge(entry *Entry, mapValues map[string]interface{}, opts ...MergeOption) error {
+	currentMap := f.rootMap(entry)
+	for _, key := range f.Keys {
+		currentMap = f.getNestedMap(currentMap, key)
+	}
+
+	cfg := newMergeConfig(opts...)
+	return mergeInto(currentMap, mapValues, cfg)
+}
+
+// rootMap returns entry.Body as a map[string]interface{}, replacing it with
+// a fresh empty map if it is nil or not already a map.
+func (f BodyField) rootMap(entry *Entry) map[string]interface{} {
+	currentMap, ok := entry.Body.(map[string]interface{})
+	if !ok {
+		currentMap = map[string]interface{}{}
+		entry.Body = currentMap
+	}
+	return currentMap
+}
+
+func (f BodyField) getNestedMap(currentMap map[string]interface{}, key string) map[string]interface{} {
+	currentValue, ok := currentMap[key]
+	if !ok {
+		currentValue = map[string]interface{}{}
+		currentMap[key] = currentValue
+	}
+
+	nextMap, ok := currentValue.(map[string]interface{})
+	if !ok {
+		nextMap = map[string]interface{}{}
+		currentMap[key] = nextMap
+	}
+
+	return nextMap
+}
+
+// Delete removes a value from an entry's body using the field's key path
+func (f BodyField) Delete(entry *Entry) (interface{}, bool) {
+	if f.isRoot() {
+		oldBody := entry.Body
+		entry.Body = nil
+		return oldBody, true
+	}
+
+	currentMap, ok := entry.Body.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	for i, key := range f.Keys {
+		currentValue, ok := currentMap[key]
+		if !ok {
+			break
+		}
+
+		if i == len(f.Keys)-1 {
+			delete(currentMap, key)
+			return currentValue, true
+		}
+
+		currentMap, ok = currentValue.(map[string]interface{})
+		if !ok {
+			break
+		}
+	}
+
+	return nil, false
+}
+
+// UnmarshalJSON will unmarshal a field from JSON
+func (f *BodyField) UnmarshalJSON(raw []byte) error {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return fmt.Errorf("the field is not a string: %s", err)
+	}
+	field, err := newBodyField(s)
+	if err != nil {
+		return err
+	}
+	*f = field
+	return nil
+}
+
+// UnmarshalYAML will unmarshal a field from YAML
+func (f *BodyField) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return fmt.Errorf("the field is not a string: %s", err)
+	}
+	field, err := newBodyField(s)
+	if err != nil {
+		return err
+	}
+	*f = field
+	return nil
+}
+
+// MarshalYAML will marshal a field for YAML
+func (f BodyField) MarshalYAML() (interface{}, error) {
+	return f.String(), nil
+}
+
+// MarshalJSON will marshal a field for JSON
+func (f BodyField) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.String())
+}
+
+func (f BodyField) isRoot() bool {
+	return len(f.Keys) == 0
+}
+
+// NewBodyField will create a new body field from a key
+func NewBodyField(keys ...string) BodyField {
+	return BodyField{
+		Keys: keys,
+	}
+}
+
+func newBodyField(s string) (BodyField, error) {
+	keys, err := fromJSONDot(s)
+	if err != nil {
+		return BodyField{}, err
+	}
+	if keys[0] != BodyFieldPrefix {
+		return BodyField{}, fmt.Errorf("must start with 'body': %s", s)
+	}
+	keys = keys[1:]
+	return BodyField{
+		Keys: keys,
+	}, nil
+}
@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBodyFieldRootAcceptsNonMap(t *testing.T) {
+	entry := New()
+	field := NewBodyField()
+
+	require.NoError(t, field.Set(entry, "plain string body"))
+	val, ok := field.Get(entry)
+	require.True(t, ok)
+	require.Equal(t, "plain string body", val)
+}
+
+func TestBodyFieldNestedSetGetDelete(t *testing.T) {
+	entry := New()
+	entry.Body = "will be replaced"
+	field := NewBodyField("nested", "key")
+
+	require.NoError(t, field.Set(entry, "value"))
+	val, ok := field.Get(entry)
+	require.True(t, ok)
+	require.Equal(t, "value", val)
+
+	deleted, ok := field.Delete(entry)
+	require.True(t, ok)
+	require.Equal(t, "value", deleted)
+}
+
+func TestBodyFieldMergeOptions(t *testing.T) {
+	entry := New()
+	entry.Body = map[string]interface{}{"a": "old", "b": "keep"}
+
+	err := NewBodyField().Merge(entry, map[string]interface{}{"a": "new"}, WithOverrideEmptyOnly())
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"a": "old", "b": "keep"}, entry.Body)
+
+	err = NewBodyField().Merge(entry, map[string]interface{}{"a": "new"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"a": "new", "b": "keep"}, entry.Body)
+}
+
+func TestBodyFieldUnmarshal(t *testing.T) {
+	var f BodyField
+	require.NoError(t, f.UnmarshalJSON([]byte(`"body.nested.key"`)))
+	require.Equal(t, []string{"nested", "key"}, f.Keys)
+
+	var bad BodyField
+	err := bad.UnmarshalJSON([]byte(`"resource.foo"`))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "must start with 'body'")
+}
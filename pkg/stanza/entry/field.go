@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entry // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ResourceFieldPrefix is the segment that a resource field expression must
+// begin with.
+const ResourceFieldPrefix = "resource"
+
+// AttributeFieldPrefix is the segment that an attribute field expression
+// must begin with.
+const AttributeFieldPrefix = "attributes"
+
+// BodyFieldPrefix is the segment that a body field expression must begin
+// with.
+const BodyFieldPrefix = "body"
+
+// Wildcard is the special bracketed index ("[*]") that matches every
+// element of a list.
+const Wildcard = "*"
+
+// toJSONDot renders prefix and keys back into the dotted/bracketed field
+// syntax accepted by fromJSONDot, e.g. toJSONDot("resource", []string{"a",
+// "b.c"}) is "resource.a['b.c']". A key is only ever preceded by a "."
+// separator when it's rendered in plain form; bracketed keys (indices,
+// wildcards, or keys containing a dot) attach directly since the brackets
+// themselves delimit the segment.
+func toJSONDot(prefix string, keys []string) string {
+	var b strings.Builder
+	b.WriteString(prefix)
+	for _, key := range keys {
+		if isIndexOrWildcard(key) {
+			fmt.Fprintf(&b, "[%s]", key)
+			continue
+		}
+		if strings.Contains(key, ".") {
+			fmt.Fprintf(&b, "['%s']", key)
+			continue
+		}
+		b.WriteString(".")
+		b.WriteString(key)
+	}
+	return b.String()
+}
+
+func isIndexOrWildcard(key string) bool {
+	if key == Wildcard {
+		return true
+	}
+	_, err := strconv.Atoi(key)
+	return err == nil
+}
+
+// fromJSONDot parses a field expression such as "resource.items[0].name" or
+// "resource.containers[*].image" into its component keys. A bracketed
+// segment may hold a quoted map key (['a.b']), a bare or negative integer
+// list index ([0], [-1]), or the wildcard [*], which matches every element
+// of a list.
+func fromJSONDot(s string) ([]string, error) {
+	var keys []string
+
+	for len(s) > 0 {
+		switch s[0] {
+		case '.':
+			s = s[1:]
+		case '[':
+			end := strings.IndexByte(s, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("found unclosed left bracket")
+			}
+			keys = append(keys, strings.Trim(s[1:end], `'"`))
+			s = s[end+1:]
+		default:
+			end := strings.IndexAny(s, ".[")
+			if end == -1 {
+				end = len(s)
+			}
+			keys = append(keys, s[:end])
+			s = s[end:]
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("field must not be empty")
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,158 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entry // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Transformer computes the merged value for a key that exists in both the
+// destination and source maps passed to Merge.
+type Transformer func(dst, src interface{}) (interface{}, error)
+
+// MergeOption configures how ResourceField.Merge (and, transitively,
+// ResourceField.Set) reconciles a key present in both the destination and an
+// incoming source map.
+type MergeOption func(*mergeConfig)
+
+// WithOverride makes incoming values win over existing ones. This is the
+// default behavior when no options are given.
+func WithOverride() MergeOption {
+	return func(c *mergeConfig) {
+		c.strategy = strategyOverride
+	}
+}
+
+// WithOverrideEmptyOnly only lets an incoming value replace an existing one
+// when the existing value is the zero value for its type (nil, "", an empty
+// slice, or an empty map).
+func WithOverrideEmptyOnly() MergeOption {
+	return func(c *mergeConfig) {
+		c.strategy = strategyOverrideEmptyOnly
+	}
+}
+
+// WithAppendSlice concatenates destination and source values when both are
+// slices, instead of replacing the destination slice.
+func WithAppendSlice() MergeOption {
+	return func(c *mergeConfig) {
+		c.appendSlice = true
+	}
+}
+
+// WithTypeCheck causes Merge to return an error when a key's existing value
+// and incoming value have different concrete types, instead of silently
+// applying the configured strategy.
+func WithTypeCheck() MergeOption {
+	return func(c *mergeConfig) {
+		c.typeCheck = true
+	}
+}
+
+// WithTransformer overrides the merge strategy entirely for conflicting
+// keys, delegating the reduction to fn. fn takes precedence over
+// WithOverride, WithOverrideEmptyOnly, and WithAppendSlice.
+func WithTransformer(fn Transformer) MergeOption {
+	return func(c *mergeConfig) {
+		c.transformer = fn
+	}
+}
+
+type mergeStrategy int
+
+const (
+	strategyOverride mergeStrategy = iota
+	strategyOverrideEmptyOnly
+)
+
+type mergeConfig struct {
+	strategy    mergeStrategy
+	appendSlice bool
+	typeCheck   bool
+	transformer Transformer
+}
+
+func newMergeConfig(opts ...MergeOption) mergeConfig {
+	cfg := mergeConfig{strategy: strategyOverride}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// mergeInto merges src into dst in place according to cfg, recursing into
+// nested maps so that only conflicting leaf keys are reconciled by the
+// configured strategy.
+func mergeInto(dst, src map[string]interface{}, cfg mergeConfig) error {
+	for key, srcVal := range src {
+		dstVal, exists := dst[key]
+		if !exists {
+			dst[key] = srcVal
+			continue
+		}
+
+		if dstMap, ok := dstVal.(map[string]interface{}); ok {
+			if srcMap, ok := srcVal.(map[string]interface{}); ok {
+				if err := mergeInto(dstMap, srcMap, cfg); err != nil {
+					return fmt.Errorf("%s: %w", key, err)
+				}
+				continue
+			}
+		}
+
+		merged, err := mergeValue(dstVal, srcVal, cfg)
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		dst[key] = merged
+	}
+	return nil
+}
+
+func mergeValue(dstVal, srcVal interface{}, cfg mergeConfig) (interface{}, error) {
+	if cfg.typeCheck && dstVal != nil && srcVal != nil {
+		if fmt.Sprintf("%T", dstVal) != fmt.Sprintf("%T", srcVal) {
+			return nil, fmt.Errorf("cannot merge values of type %T and %T", dstVal, srcVal)
+		}
+	}
+
+	if cfg.transformer != nil {
+		return cfg.transformer(dstVal, srcVal)
+	}
+
+	if cfg.appendSlice {
+		dstSlice, dstIsSlice := dstVal.([]interface{})
+		srcSlice, srcIsSlice := srcVal.([]interface{})
+		if dstIsSlice && srcIsSlice {
+			merged := make([]interface{}, 0, len(dstSlice)+len(srcSlice))
+			merged = append(merged, dstSlice...)
+			merged = append(merged, srcSlice...)
+			return merged, nil
+		}
+	}
+
+	switch cfg.strategy {
+	case strategyOverrideEmptyOnly:
+		if isEmptyValue(dstVal) {
+			return srcVal, nil
+		}
+		return dstVal, nil
+	default:
+		return srcVal, nil
+	}
+}
+
+func isEmptyValue(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Map:
+		return rv.Len() == 0
+	default:
+		return rv.IsZero()
+	}
+}
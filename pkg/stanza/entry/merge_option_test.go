@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entry
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceFieldMergeOptions(t *testing.T) {
+	cases := []struct {
+		name     string
+		dst      map[string]interface{}
+		src      map[string]interface{}
+		opts     []MergeOption
+		expected map[string]interface{}
+	}{
+		{
+			"DefaultOverride",
+			map[string]interface{}{"a": "old", "b": "keep"},
+			map[string]interface{}{"a": "new"},
+			nil,
+			map[string]interface{}{"a": "new", "b": "keep"},
+		},
+		{
+			"ExplicitOverride",
+			map[string]interface{}{"a": "old"},
+			map[string]interface{}{"a": "new"},
+			[]MergeOption{WithOverride()},
+			map[string]interface{}{"a": "new"},
+		},
+		{
+			"OverrideEmptyOnlySkipsNonEmpty",
+			map[string]interface{}{"a": "old"},
+			map[string]interface{}{"a": "new"},
+			[]MergeOption{WithOverrideEmptyOnly()},
+			map[string]interface{}{"a": "old"},
+		},
+		{
+			"OverrideEmptyOnlyFillsEmpty",
+			map[string]interface{}{"a": ""},
+			map[string]interface{}{"a": "new"},
+			[]MergeOption{WithOverrideEmptyOnly()},
+			map[string]interface{}{"a": "new"},
+		},
+		{
+			"AppendSlice",
+			map[string]interface{}{"a": []interface{}{"x"}},
+			map[string]interface{}{"a": []interface{}{"y"}},
+			[]MergeOption{WithAppendSlice()},
+			map[string]interface{}{"a": []interface{}{"x", "y"}},
+		},
+		{
+			"DeepNestedMaps",
+			map[string]interface{}{
+				"outer": map[string]interface{}{
+					"inner": map[string]interface{}{"a": "old", "b": "keep"},
+				},
+			},
+			map[string]interface{}{
+				"outer": map[string]interface{}{
+					"inner": map[string]interface{}{"a": "new"},
+				},
+			},
+			nil,
+			map[string]interface{}{
+				"outer": map[string]interface{}{
+					"inner": map[string]interface{}{"a": "new", "b": "keep"},
+				},
+			},
+		},
+		{
+			"SliceOfMaps",
+			map[string]interface{}{"items": []interface{}{map[string]interface{}{"id": 1}}},
+			map[string]interface{}{"items": []interface{}{map[string]interface{}{"id": 2}}},
+			[]MergeOption{WithAppendSlice()},
+			map[string]interface{}{"items": []interface{}{
+				map[string]interface{}{"id": 1},
+				map[string]interface{}{"id": 2},
+			}},
+		},
+		{
+			"Transformer",
+			map[string]interface{}{"count": 1},
+			map[string]interface{}{"count": 2},
+			[]MergeOption{WithTransformer(func(dst, src interface{}) (interface{}, error) {
+				return dst.(int) + src.(int), nil
+			})},
+			map[string]interface{}{"count": 3},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			entry := New()
+			entry.Resource = tc.dst
+			field := NewResourceField()
+			err := field.Merge(entry, tc.src, tc.opts...)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, entry.Resource)
+		})
+	}
+}
+
+func TestResourceFieldMergeTypeCheck(t *testing.T) {
+	entry := New()
+	entry.Resource = map[string]interface{}{"a": "string"}
+	field := NewResourceField()
+	err := field.Merge(entry, map[string]interface{}{"a": 123}, WithTypeCheck())
+	require.Error(t, err)
+}
+
+func TestResourceFieldMergeTransformerError(t *testing.T) {
+	entry := New()
+	entry.Resource = map[string]interface{}{"a": "old"}
+	field := NewResourceField()
+	boom := errors.New("boom")
+	err := field.Merge(entry, map[string]interface{}{"a": "new"}, WithTransformer(func(_, _ interface{}) (interface{}, error) {
+		return nil, boom
+	}))
+	require.ErrorIs(t, err, boom)
+}
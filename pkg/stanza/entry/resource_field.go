@@ -0,0 +1,395 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entry // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// ResourceField represents a potential field on an entry's resource
+type ResourceField struct {
+	Keys []string
+}
+
+// Parent returns the parent of the current field
+func (f ResourceField) Parent() ResourceField {
+	if f.isRoot() {
+		return f
+	}
+	keys := f.Keys[:len(f.Keys)-1]
+	return ResourceField{keys}
+}
+
+// Child returns a child of the current field
+func (f ResourceField) Child(key string) ResourceField {
+	child := make([]string, len(f.Keys), len(f.Keys)+1)
+	copy(child, f.Keys)
+	child = append(child, key)
+	return ResourceField{child}
+}
+
+// String returns the string representation of this field
+func (f ResourceField) String() string {
+	return toJSONDot(ResourceFieldPrefix, f.Keys)
+}
+
+// Get will return the specified value from the entry's resource. A key that
+// addresses a list index (e.g. "items[0]") returns that element. A key that
+// is the wildcard "[*]" fans out over every element of the list at that
+// point and returns their (possibly further-traversed) values as []any.
+func (f ResourceField) Get(entry *Entry) (interface{}, bool) {
+	if entry.Resource == nil {
+		return "", false
+	}
+
+	return getAtKeys(entry.Resource, f.Keys)
+}
+
+// HasWildcard reports whether this field contains a "[*]" segment.
+func (f ResourceField) HasWildcard() bool {
+	for _, key := range f.Keys {
+		if key == Wildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateAddressable returns an error if this field contains a wildcard, as
+// a wildcard fans out over every matching element and so cannot name a
+// single target. Operators that require a single addressable location (e.g.
+// a field to overwrite with a computed value) should call this from their
+// own Validate.
+func (f ResourceField) ValidateAddressable() error {
+	if f.HasWildcard() {
+		return fmt.Errorf("field %s contains a wildcard and cannot be used as a single addressable target", f)
+	}
+	return nil
+}
+
+// hasIndexOrWildcard reports whether any key in keys addresses a list: a
+// bare/negative integer index or the wildcard. Set routes through the
+// slice-aware setAtKeys whenever this is true, not just for wildcards,
+// since a plain numeric index must also be treated as a list index rather
+// than a map key.
+func hasIndexOrWildcard(keys []string) bool {
+	for _, key := range keys {
+		if key == Wildcard {
+			return true
+		}
+		if _, err := strconv.Atoi(key); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func getAtKeys(value interface{}, keys []string) (interface{}, bool) {
+	if len(keys) == 0 {
+		return value, true
+	}
+
+	key, rest := keys[0], keys[1:]
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		next, ok := v[key]
+		if !ok {
+			return nil, false
+		}
+		return getAtKeys(next, rest)
+	case []interface{}:
+		if key == Wildcard {
+			results := make([]interface{}, 0, len(v))
+			for _, elem := range v {
+				if out, ok := getAtKeys(elem, rest); ok {
+					results = append(results, out)
+				}
+			}
+			return results, true
+		}
+		idx, ok := resolveIndex(key, len(v))
+		if !ok {
+			return nil, false
+		}
+		return getAtKeys(v[idx], rest)
+	default:
+		return nil, false
+	}
+}
+
+// resolveIndex parses key as a (possibly negative) list index and resolves
+// it against length, returning false if key isn't an integer or is out of
+// range once resolved.
+func resolveIndex(key string, length int) (int, bool) {
+	idx, err := strconv.Atoi(key)
+	if err != nil {
+		return 0, false
+	}
+	if idx < 0 {
+		idx += length
+	}
+	if idx < 0 || idx >= length {
+		return 0, false
+	}
+	return idx, true
+}
+
+// Set will set the value on the entry's resource using the field's key path.
+// If val is a map and the path has no list index or wildcard, it is merged
+// into the existing value at that path using the default MergeOptions (new
+// keys win); see Merge for finer control. A key addressing a list index sets
+// that element in place; the wildcard "[*]" broadcasts val to every element
+// matched at that point in the path. On such a path val is assigned as is,
+// replacing the old value, even when it is a map.
+func (f ResourceField) Set(entry *Entry, val interface{}) error {
+	if entry.Resource == nil {
+		entry.Resource = map[string]interface{}{}
+	}
+
+	if hasIndexOrWildcard(f.Keys) {
+		if f.isRoot() {
+			return fmt.Errorf("cannot set resource to non-map type")
+		}
+		return setAtKeys(entry.Resource, f.Keys, val)
+	}
+
+	if mapValue, ok := val.(map[string]interface{}); ok {
+		return f.Merge(entry, mapValue)
+	}
+
+	if f.isRoot() {
+		return fmt.Errorf("cannot set resource to non-map type")
+	}
+
+	currentMap := entry.Resource
+	for i, key := range f.Keys {
+		if i == len(f.Keys)-1 {
+			currentMap[key] = val
+			break
+		}
+		currentMap = f.getNestedMap(currentMap, key)
+	}
+	return nil
+}
+
+func setAtKeys(container interface{}, keys []string, val interface{}) error {
+	key, rest := keys[0], keys[1:]
+
+	switch c := container.(type) {
+	case map[string]interface{}:
+		if key == Wildcard {
+			return fmt.Errorf("wildcard is not valid as a map key")
+		}
+		if len(rest) == 0 {
+			c[key] = val
+			return nil
+		}
+		next, ok := c[key]
+		if !ok || !isContainer(next) {
+			next = map[string]interface{}{}
+			c[key] = next
+		}
+		return setAtKeys(next, rest, val)
+	case []interface{}:
+		if key == Wildcard {
+			for i := range c {
+				if len(rest) == 0 {
+					c[i] = val
+					continue
+				}
+				if err := setAtKeys(c[i], rest, val); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		idx, ok := resolveIndex(key, len(c))
+		if !ok {
+			return fmt.Errorf("list index %q is out of range", key)
+		}
+		if len(rest) == 0 {
+			c[idx] = val
+			return nil
+		}
+		return setAtKeys(c[idx], rest, val)
+	default:
+		return fmt.Errorf("cannot address an index or wildcard into a non-list value")
+	}
+}
+
+func isContainer(v interface{}) bool {
+	switch v.(type) {
+	case map[string]interface{}, []interface{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// Merge merges mapValues into the entry's resource at the field's key path.
+// By default it preserves the historical behavior of this package: keys in
+// mapValues always win over whatever is already present. Pass MergeOptions
+// to change that - e.g. WithOverrideEmptyOnly to only fill zero values, or
+// WithAppendSlice to concatenate rather than replace slices.
+func (f ResourceField) Merge(entry *Entry, mapValues map[string]interface{}, opts ...MergeOption) error {
+	currentMap := entry.Resource
+	for _, key := range f.Keys {
+		currentMap = f.getNestedMap(currentMap, key)
+	}
+
+	cfg := newMergeConfig(opts...)
+	return mergeInto(currentMap, mapValues, cfg)
+}
+
+func (f ResourceField) getNestedMap(currentMap map[string]interface{}, key string) map[string]interface{} {
+	currentValue, ok := currentMap[key]
+	if !ok {
+		currentValue = map[string]interface{}{}
+		currentMap[key] = currentValue
+	}
+
+	nextMap, ok := currentValue.(map[string]interface{})
+	if !ok {
+		nextMap = map[string]interface{}{}
+		currentMap[key] = nextMap
+	}
+
+	return nextMap
+}
+
+// Delete removes a value from an entry's resource using the field's key
+// path. A wildcard "[*]" removes every match and returns them as []any. List
+// elements can't be removed without shifting sibling indices, so a deleted
+// list element is left in place as a nil entry; see FindNil for locating
+// the sparse slots this can leave behind.
+func (f ResourceField) Delete(entry *Entry) (interface{}, bool) {
+	if entry.Resource == nil {
+		return "", false
+	}
+
+	if f.isRoot() {
+		oldResource := entry.Resource
+		entry.Resource = nil
+		return oldResource, true
+	}
+
+	return deleteAtKeys(entry.Resource, f.Keys)
+}
+
+func deleteAtKeys(container interface{}, keys []string) (interface{}, bool) {
+	key, rest := keys[0], keys[1:]
+
+	switch c := container.(type) {
+	case map[string]interface{}:
+		if len(rest) == 0 {
+			if key == Wildcard {
+				return nil, false
+			}
+			v, ok := c[key]
+			if !ok {
+				return nil, false
+			}
+			delete(c, key)
+			return v, true
+		}
+		next, ok := c[key]
+		if !ok {
+			return nil, false
+		}
+		return deleteAtKeys(next, rest)
+	case []interface{}:
+		if key == Wildcard {
+			var removed []interface{}
+			for i, elem := range c {
+				if len(rest) == 0 {
+					removed = append(removed, elem)
+					c[i] = nil
+					continue
+				}
+				if v, ok := deleteAtKeys(elem, rest); ok {
+					removed = append(removed, v)
+				}
+			}
+			return removed, len(removed) > 0
+		}
+		idx, ok := resolveIndex(key, len(c))
+		if !ok {
+			return nil, false
+		}
+		if len(rest) == 0 {
+			v := c[idx]
+			c[idx] = nil
+			return v, true
+		}
+		return deleteAtKeys(c[idx], rest)
+	default:
+		return nil, false
+	}
+}
+
+// UnmarshalJSON will unmarshal a field from JSON
+func (f *ResourceField) UnmarshalJSON(raw []byte) error {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return fmt.Errorf("the field is not a string: %s", err)
+	}
+	field, err := newResourceField(s)
+	if err != nil {
+		return err
+	}
+	*f = field
+	return nil
+}
+
+// UnmarshalYAML will unmarshal a field from YAML
+func (f *ResourceField) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return fmt.Errorf("the field is not a string: %s", err)
+	}
+	field, err := newResourceField(s)
+	if err != nil {
+		return err
+	}
+	*f = field
+	return nil
+}
+
+// MarshalYAML will marshal a field for YAML
+func (f ResourceField) MarshalYAML() (interface{}, error) {
+	return f.String(), nil
+}
+
+// MarshalJSON will marshal a field for JSON
+func (f ResourceField) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.String())
+}
+
+func (f ResourceField) isRoot() bool {
+	return len(f.Keys) == 0
+}
+
+// NewResourceField will create a new resource field from a key
+func NewResourceField(keys ...string) ResourceField {
+	return ResourceField{
+		Keys: keys,
+	}
+}
+
+func newResourceField(s string) (ResourceField, error) {
+	keys, err := fromJSONDot(s)
+	if err != nil {
+		return ResourceField{}, err
+	}
+	if keys[0] != ResourceFieldPrefix {
+		return ResourceField{}, fmt.Errorf("must start with 'resource': %s", s)
+	}
+	keys = keys[1:]
+	return ResourceField{
+		Keys: keys,
+	}, nil
+}
@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entry // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+
+import (
+	"sort"
+	"strconv"
+)
+
+// FindNil walks the resource map rooted at this field and reports the path,
+// in the same dotted/bracketed syntax accepted by the unmarshaler, to the
+// first nil element found inside a list - e.g. "resource.items[3]" or
+// "resource.spec.containers[1].env[0]". It reports found=false if the field
+// doesn't resolve or no nil list element exists beneath it.
+//
+// Stanza operators that build resource payloads from templated logs
+// frequently emit sparse arrays (see Delete, which can itself leave nil
+// entries behind); FindNil gives those payloads a way to be detected before
+// they reach an exporter's marshaler.
+func (f ResourceField) FindNil(entry *Entry) (path string, found bool) {
+	value, ok := f.Get(entry)
+	if !ok {
+		return "", false
+	}
+
+	suffix, found := findNilKeys(value)
+	if !found {
+		return "", false
+	}
+
+	full := ResourceField{Keys: append(append([]string{}, f.Keys...), suffix...)}
+	return full.String(), true
+}
+
+// findNilKeys searches value depth-first for the first nil entry inside a
+// list, returning the key path - relative to value - leading to it. Map
+// keys are visited in sorted order so the result is deterministic.
+func findNilKeys(value interface{}) ([]string, bool) {
+	switch v := value.(type) {
+	case []interface{}:
+		for i, elem := range v {
+			if elem == nil {
+				return []string{indexKey(i)}, true
+			}
+			if keys, found := findNilKeys(elem); found {
+				return append([]string{indexKey(i)}, keys...), true
+			}
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if nested, found := findNilKeys(v[key]); found {
+				return append([]string{key}, nested...), true
+			}
+		}
+	}
+	return nil, false
+}
+
+func indexKey(i int) string {
+	return strconv.Itoa(i)
+}
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceFieldFindNil(t *testing.T) {
+	cases := []struct {
+		name         string
+		resource     map[string]interface{}
+		expectedPath string
+		expectedOK   bool
+	}{
+		{
+			"NoNil",
+			map[string]interface{}{"items": []interface{}{"a", "b"}},
+			"",
+			false,
+		},
+		{
+			"EmptyList",
+			map[string]interface{}{"items": []interface{}{}},
+			"",
+			false,
+		},
+		{
+			"ListOfScalars",
+			map[string]interface{}{"items": []interface{}{"a", nil, "c"}},
+			"resource.items[1]",
+			true,
+		},
+		{
+			"DeeplyNested",
+			map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"env": []interface{}{"A=1"}},
+						map[string]interface{}{"env": []interface{}{"B=2", nil}},
+					},
+				},
+			},
+			"resource.spec.containers[1].env[1]",
+			true,
+		},
+		{
+			"NoListsAtAll",
+			map[string]interface{}{"a": map[string]interface{}{"b": "c"}},
+			"",
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			entry := New()
+			entry.Resource = tc.resource
+			path, ok := NewResourceField().FindNil(entry)
+			require.Equal(t, tc.expectedOK, ok)
+			require.Equal(t, tc.expectedPath, path)
+		})
+	}
+}
+
+func TestResourceFieldFindNilUnresolvedField(t *testing.T) {
+	entry := New()
+	entry.Resource = map[string]interface{}{}
+	_, ok := NewResourceField("missing").FindNil(entry)
+	require.False(t, ok)
+}
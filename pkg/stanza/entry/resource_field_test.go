@@ -416,6 +416,21 @@ func TestResourceFieldUnmarshal(t *testing.T) {
 			"resource['test.foo'].bar",
 			[]string{"test.foo", "bar"},
 		},
+		{
+			"index",
+			"resource.items[0].name",
+			[]string{"items", "0", "name"},
+		},
+		{
+			"negative_index",
+			"resource.items[-1]",
+			[]string{"items", "-1"},
+		},
+		{
+			"wildcard",
+			"resource.containers[*].image",
+			[]string{"containers", "*", "image"},
+		},
 	}
 
 	for _, tc := range cases {
@@ -433,6 +448,189 @@ func TestResourceFieldUnmarshal(t *testing.T) {
 	}
 }
 
+func TestResourceFieldString(t *testing.T) {
+	cases := []struct {
+		name     string
+		keys     []string
+		expected string
+	}{
+		{"root", []string{}, "resource"},
+		{"standard", []string{"test"}, "resource.test"},
+		{"bracketed", []string{"test.foo"}, "resource['test.foo']"},
+		{"double_bracketed", []string{"test.foo", "bar.baz"}, "resource['test.foo']['bar.baz']"},
+		{"mixed", []string{"test.foo", "bar"}, "resource['test.foo'].bar"},
+		{"index", []string{"items", "0", "name"}, "resource.items[0].name"},
+		{"wildcard", []string{"containers", "*", "image"}, "resource.containers[*].image"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			field := ResourceField{tc.keys}
+			require.Equal(t, tc.expected, field.String())
+
+			// String must round-trip through fromJSONDot back to the same keys.
+			var fy ResourceField
+			require.NoError(t, yaml.UnmarshalStrict([]byte(field.String()), &fy))
+			require.Equal(t, tc.keys, fy.Keys)
+		})
+	}
+}
+
+func TestResourceFieldValidateAddressable(t *testing.T) {
+	require.NoError(t, NewResourceField("items", "0", "name").ValidateAddressable())
+	require.NoError(t, NewResourceField("simple_key").ValidateAddressable())
+
+	err := NewResourceField("containers", "*", "image").ValidateAddressable()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "wildcard")
+}
+
+func TestResourceFieldGetIndexAndWildcard(t *testing.T) {
+	cases := []struct {
+		name       string
+		field      Field
+		resource   map[string]interface{}
+		expected   interface{}
+		expectedOK bool
+	}{
+		{
+			"Index",
+			NewResourceField("items", "0", "name"),
+			map[string]interface{}{
+				"items": []interface{}{
+					map[string]interface{}{"name": "first"},
+					map[string]interface{}{"name": "second"},
+				},
+			},
+			"first",
+			true,
+		},
+		{
+			"NegativeIndex",
+			NewResourceField("items", "-1", "name"),
+			map[string]interface{}{
+				"items": []interface{}{
+					map[string]interface{}{"name": "first"},
+					map[string]interface{}{"name": "second"},
+				},
+			},
+			"second",
+			true,
+		},
+		{
+			"OutOfRangeIndex",
+			NewResourceField("items", "5"),
+			map[string]interface{}{"items": []interface{}{"a", "b"}},
+			nil,
+			false,
+		},
+		{
+			"Wildcard",
+			NewResourceField("containers", "*", "image"),
+			map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{"image": "a"},
+					map[string]interface{}{"image": "b"},
+				},
+			},
+			[]interface{}{"a", "b"},
+			true,
+		},
+		{
+			"WildcardOverEmptySlice",
+			NewResourceField("containers", "*", "image"),
+			map[string]interface{}{"containers": []interface{}{}},
+			[]interface{}{},
+			true,
+		},
+		{
+			"WildcardOverNonSlice",
+			NewResourceField("containers", "*"),
+			map[string]interface{}{"containers": "not-a-list"},
+			nil,
+			false,
+		},
+		{
+			"MixedMapSliceChain",
+			NewResourceField("spec", "containers", "1", "env", "0"),
+			map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"env": []interface{}{"A=1"}},
+						map[string]interface{}{"env": []interface{}{"B=2", "C=3"}},
+					},
+				},
+			},
+			"B=2",
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			entry := New()
+			entry.Resource = tc.resource
+			val, ok := entry.Get(tc.field)
+			require.Equal(t, tc.expectedOK, ok)
+			require.Equal(t, tc.expected, val)
+		})
+	}
+}
+
+func TestResourceFieldSetIndexAndWildcard(t *testing.T) {
+	t.Run("Index", func(t *testing.T) {
+		entry := New()
+		entry.Resource = map[string]interface{}{"items": []interface{}{"a", "b"}}
+		err := entry.Set(NewResourceField("items", "1"), "B")
+		require.NoError(t, err)
+		require.Equal(t, map[string]interface{}{"items": []interface{}{"a", "B"}}, entry.Resource)
+	})
+
+	t.Run("Wildcard", func(t *testing.T) {
+		entry := New()
+		entry.Resource = map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"image": "a"},
+				map[string]interface{}{"image": "b"},
+			},
+		}
+		err := entry.Set(NewResourceField("containers", "*", "image"), "replaced")
+		require.NoError(t, err)
+		require.Equal(t, map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"image": "replaced"},
+				map[string]interface{}{"image": "replaced"},
+			},
+		}, entry.Resource)
+	})
+
+	t.Run("OutOfRangeIndex", func(t *testing.T) {
+		entry := New()
+		entry.Resource = map[string]interface{}{"items": []interface{}{"a"}}
+		err := entry.Set(NewResourceField("items", "5"), "x")
+		require.Error(t, err)
+	})
+}
+
+func TestResourceFieldDeleteIndexAndWildcard(t *testing.T) {
+	t.Run("Wildcard", func(t *testing.T) {
+		entry := New()
+		entry.Resource = map[string]interface{}{"items": []interface{}{"a", "b"}}
+		val, ok := entry.Delete(NewResourceField("items", "*"))
+		require.True(t, ok)
+		require.Equal(t, []interface{}{"a", "b"}, val)
+		require.Equal(t, map[string]interface{}{"items": []interface{}{nil, nil}}, entry.Resource)
+	})
+
+	t.Run("WildcardOverEmptySlice", func(t *testing.T) {
+		entry := New()
+		entry.Resource = map[string]interface{}{"items": []interface{}{}}
+		val, ok := entry.Delete(NewResourceField("items", "*"))
+		require.False(t, ok)
+		require.Nil(t, val)
+	})
+}
+
 func TestResourceFieldUnmarshalFailure(t *testing.T) {
 	cases := []struct {
 		name        string
@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package podmanreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/podmanreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// podmanClient talks to the Podman libpod REST API over the transport
+// selected by Config.Endpoint, applying Config.TLS for "tcp://"/"https://"
+// endpoints.
+type podmanClient struct {
+	// client is used for request/response calls and is bound by
+	// Config.Timeout.
+	client *http.Client
+	// streamClient is used for long-lived connections, such as the /events
+	// stream, and is not subject to Config.Timeout.
+	streamClient *http.Client
+
+	baseURL    string
+	apiVersion string
+}
+
+func newPodmanClient(cfg *Config) (*podmanClient, error) {
+	transport, baseURL, err := newTransport(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &podmanClient{
+		client:       &http.Client{Transport: transport, Timeout: cfg.Timeout},
+		streamClient: &http.Client{Transport: transport},
+		baseURL:      baseURL,
+		apiVersion:   cfg.APIVersion,
+	}, nil
+}
+
+// newTransport builds the http.RoundTripper and base URL for cfg.Endpoint.
+// "unix://" sockets dial the socket file directly. "tcp://" dials over the
+// network, in TLS only if cfg.usingTLS() (plain TCP Podman daemons are
+// common and must not be forced through a TLS handshake). "https://" always
+// dials over TLS, applying cfg.TLS when set.
+func newTransport(cfg *Config) (http.RoundTripper, string, error) {
+	if isUnixEndpoint(cfg.Endpoint) {
+		sockPath := strings.TrimPrefix(cfg.Endpoint, "unix://")
+		return &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", sockPath)
+			},
+		}, "http://podman", nil
+	}
+
+	endpoint := cfg.Endpoint
+	useTLS := strings.HasPrefix(endpoint, "https://")
+	if strings.HasPrefix(endpoint, "tcp://") {
+		useTLS = cfg.usingTLS()
+		scheme := "http"
+		if useTLS {
+			scheme = "https"
+		}
+		endpoint = scheme + "://" + strings.TrimPrefix(endpoint, "tcp://")
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, "", fmt.Errorf("parse endpoint: %w", err)
+	}
+
+	if !useTLS {
+		return &http.Transport{}, u.Scheme + "://" + u.Host, nil
+	}
+
+	tlsConfig, err := cfg.TLS.LoadTLSConfig()
+	if err != nil {
+		return nil, "", fmt.Errorf("load TLS config: %w", err)
+	}
+
+	return &http.Transport{TLSClientConfig: tlsConfig}, u.Scheme + "://" + u.Host, nil
+}
+
+func (c *podmanClient) url(path string) string {
+	version := strings.TrimPrefix(c.apiVersion, "v")
+	if version == "" {
+		version = "4.0.0"
+	}
+	return fmt.Sprintf("%s/v%s/libpod%s", c.baseURL, version, path)
+}
+
+// get issues a GET request against path and decodes a JSON response body
+// into out. A nil out discards the response body.
+func (c *podmanClient) get(ctx context.Context, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url(path), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("podman API %s returned %s", path, resp.Status)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// openStream issues a GET request against path using streamClient and
+// returns the still-open response body for the caller to decode
+// incrementally and close.
+func (c *podmanClient) openStream(ctx context.Context, path string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url(path), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.streamClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("podman API %s returned %s", path, resp.Status)
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,264 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package podmanreceiver
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
+func TestPodmanClientTLS(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4.0.0/libpod/containers/json", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"Id":"abc123"}]`))
+	})
+
+	server := httptest.NewTLSServer(mux)
+	defer server.Close()
+
+	// Write the server's certificate out so the client can trust it via
+	// Config.TLS.CAFile, mirroring how an operator would point at a CA
+	// bundle for a TLS-terminated remote Podman endpoint.
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, writeCertPEM(caFile, server.Certificate().Raw))
+
+	cfg := &Config{
+		Endpoint: "tcp://" + server.Listener.Addr().String(),
+		Timeout:  5 * time.Second,
+		TLS: configtls.TLSClientSetting{
+			TLSSetting: configtls.TLSSetting{CAFile: caFile},
+		},
+	}
+
+	client, err := newPodmanClient(cfg)
+	require.NoError(t, err)
+
+	ids, err := client.listContainers(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"abc123"}, ids)
+}
+
+func TestPodmanClientTLSRejectsUntrustedServer(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4.0.0/libpod/containers/json", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[]`))
+	})
+
+	server := httptest.NewTLSServer(mux)
+	defer server.Close()
+
+	cfg := &Config{
+		Endpoint: "https://" + server.Listener.Addr().String(),
+		Timeout:  5 * time.Second,
+	}
+
+	client, err := newPodmanClient(cfg)
+	require.NoError(t, err)
+
+	_, err = client.listContainers(context.Background())
+	require.Error(t, err)
+}
+
+// TestPodmanClientMTLS verifies that Config.TLS.CertFile/KeyFile present a
+// client certificate the server actually requires and verifies, proving
+// mTLS (not just server-trust TLS) is wired through.
+func TestPodmanClientMTLS(t *testing.T) {
+	serverCertPEM, _, serverCert := generateTestCert(t)
+	clientCertPEM, clientKeyPEM, _ := generateTestCert(t)
+
+	clientCAs := x509.NewCertPool()
+	require.True(t, clientCAs.AppendCertsFromPEM(clientCertPEM))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4.0.0/libpod/containers/json", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"Id":"mtls123"}]`))
+	})
+
+	server := httptest.NewUnstartedServer(mux)
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, serverCertPEM, 0o600))
+	clientCertFile := filepath.Join(dir, "client.pem")
+	require.NoError(t, os.WriteFile(clientCertFile, clientCertPEM, 0o600))
+	clientKeyFile := filepath.Join(dir, "client-key.pem")
+	require.NoError(t, os.WriteFile(clientKeyFile, clientKeyPEM, 0o600))
+
+	cfg := &Config{
+		Endpoint: "tcp://" + server.Listener.Addr().String(),
+		Timeout:  5 * time.Second,
+		TLS: configtls.TLSClientSetting{
+			TLSSetting: configtls.TLSSetting{
+				CAFile:   caFile,
+				CertFile: clientCertFile,
+				KeyFile:  clientKeyFile,
+			},
+		},
+	}
+
+	client, err := newPodmanClient(cfg)
+	require.NoError(t, err)
+
+	ids, err := client.listContainers(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"mtls123"}, ids)
+}
+
+// TestPodmanClientMTLSRejectsMissingClientCert verifies that a server
+// requiring a client certificate refuses a client that doesn't present one.
+func TestPodmanClientMTLSRejectsMissingClientCert(t *testing.T) {
+	serverCertPEM, _, serverCert := generateTestCert(t)
+	otherClientCertPEM, _, _ := generateTestCert(t)
+
+	clientCAs := x509.NewCertPool()
+	require.True(t, clientCAs.AppendCertsFromPEM(otherClientCertPEM))
+
+	server := httptest.NewUnstartedServer(http.NewServeMux())
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, serverCertPEM, 0o600))
+
+	cfg := &Config{
+		Endpoint: "tcp://" + server.Listener.Addr().String(),
+		Timeout:  5 * time.Second,
+		TLS: configtls.TLSClientSetting{
+			TLSSetting: configtls.TLSSetting{CAFile: caFile},
+		},
+	}
+
+	client, err := newPodmanClient(cfg)
+	require.NoError(t, err)
+
+	_, err = client.listContainers(context.Background())
+	require.Error(t, err)
+}
+
+// TestPodmanClientPlainTCP verifies that a "tcp://" endpoint with no TLS
+// configured talks plain HTTP rather than being forced through a TLS
+// handshake, matching an un-terminated Podman daemon exposed over TCP.
+func TestPodmanClientPlainTCP(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4.0.0/libpod/containers/json", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"Id":"plain789"}]`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	cfg := &Config{
+		Endpoint: "tcp://" + server.Listener.Addr().String(),
+		Timeout:  5 * time.Second,
+	}
+
+	client, err := newPodmanClient(cfg)
+	require.NoError(t, err)
+
+	ids, err := client.listContainers(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"plain789"}, ids)
+}
+
+func TestPodmanClientUnixSocket(t *testing.T) {
+	sockPath := filepath.Join(t.TempDir(), "podman.sock")
+	listener, err := net.Listen("unix", sockPath)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4.0.0/libpod/containers/json", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"Id":"def456"}]`))
+	})
+
+	server := &http.Server{Handler: mux}
+	go server.Serve(listener)
+	defer server.Close()
+
+	cfg := &Config{
+		Endpoint: "unix://" + sockPath,
+		Timeout:  5 * time.Second,
+	}
+
+	client, err := newPodmanClient(cfg)
+	require.NoError(t, err)
+
+	ids, err := client.listContainers(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"def456"}, ids)
+}
+
+func writeCertPEM(path string, der []byte) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return pem.Encode(f, &pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+// generateTestCert returns a self-signed certificate/key pair, PEM-encoded
+// and as a tls.Certificate, valid for both server and client auth so it can
+// stand in as its own trust anchor in tests.
+func generateTestCert(t *testing.T) (certPEM, keyPEM []byte, cert tls.Certificate) {
+	t.Helper()
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "podmanreceiver-test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &priv.PublicKey, priv)
+	require.NoError(t, err)
+
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	keyBytes, err := x509.MarshalECPrivateKey(priv)
+	require.NoError(t, err)
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes})
+
+	cert, err = tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+	return certPEM, keyPEM, cert
+}
@@ -5,9 +5,11 @@ package podmanreceiver // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"errors"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 )
 
@@ -25,8 +27,42 @@ type Config struct {
 	APIVersion    string `mapstructure:"api_version"`
 	SSHKey        string `mapstructure:"ssh_key"`
 	SSHPassphrase string `mapstructure:"ssh_passphrase"`
+
+	// TLS configures the client transport used when Endpoint points at a
+	// "tcp://" or "https://" Podman API, e.g. a rootful daemon exposed via a
+	// systemd socket unit or a remote node behind a reverse proxy. Validate
+	// rejects it for "unix://" endpoints.
+	TLS configtls.TLSClientSetting `mapstructure:"tls"`
+
+	// Mode selects how the scraper decides when to pull container stats.
+	// "poll" (the default) scrapes every container on each CollectionInterval
+	// tick. "events" instead watches Podman's /events stream and only scrapes
+	// containers that are running, refreshing the running set on
+	// EventsRefreshInterval to guard against missed events.
+	Mode string `mapstructure:"mode"`
+
+	// EventsRefreshInterval is the fallback interval at which the running
+	// container set is reconciled against the Podman API while in "events"
+	// mode. Validate rejects it in "poll" mode. Default is 5m.
+	EventsRefreshInterval time.Duration `mapstructure:"events_refresh_interval"`
+
+	// CollectPods enables emitting podman.pod.* metrics, aggregated across a
+	// pod's infra and workload containers, in addition to the existing
+	// per-container metrics. Container-level metrics gain a podman.pod.id
+	// resource attribute when the container belongs to a pod.
+	CollectPods bool `mapstructure:"collect_pods"`
+
+	// PodLabelsAsAttributes is the list of pod label keys to attach as
+	// resource attributes on podman.pod.* metrics. Validate rejects it when
+	// CollectPods is false.
+	PodLabelsAsAttributes []string `mapstructure:"pod_labels_as_attributes"`
 }
 
+const (
+	modePoll   = "poll"
+	modeEvents = "events"
+)
+
 func (config Config) Validate() error {
 	if config.Endpoint == "" {
 		return errors.New("config.Endpoint must be specified")
@@ -34,5 +70,39 @@ func (config Config) Validate() error {
 	if config.CollectionInterval == 0 {
 		return errors.New("config.CollectionInterval must be specified")
 	}
+	if isUnixEndpoint(config.Endpoint) && config.usingTLS() {
+		return errors.New("config.TLS must not be specified when config.Endpoint is a unix socket")
+	}
+
+	switch config.Mode {
+	case "", modePoll:
+		if config.EventsRefreshInterval != 0 {
+			return errors.New("config.EventsRefreshInterval must not be specified when config.Mode is \"poll\"")
+		}
+	case modeEvents:
+		if config.EventsRefreshInterval < 0 {
+			return errors.New("config.EventsRefreshInterval must be positive")
+		}
+	default:
+		return errors.New("config.Mode must be either \"poll\" or \"events\"")
+	}
+
+	if !config.CollectPods && len(config.PodLabelsAsAttributes) > 0 {
+		return errors.New("config.PodLabelsAsAttributes must not be specified when config.CollectPods is false")
+	}
+
 	return nil
 }
+
+// usingTLS reports whether any TLS client setting has been configured.
+func (config Config) usingTLS() bool {
+	return config.TLS.CAFile != "" ||
+		config.TLS.CertFile != "" ||
+		config.TLS.KeyFile != "" ||
+		config.TLS.ServerNameOverride != "" ||
+		config.TLS.InsecureSkipVerify
+}
+
+func isUnixEndpoint(endpoint string) bool {
+	return strings.HasPrefix(endpoint, "unix://")
+}
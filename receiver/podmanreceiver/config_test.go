@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package podmanreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+)
+
+func validConfig() *Config {
+	return &Config{
+		ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+			CollectionInterval: 10 * time.Second,
+		},
+		Endpoint: "unix:///run/podman/podman.sock",
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	cases := []struct {
+		name        string
+		modify      func(cfg *Config)
+		expectedErr string
+	}{
+		{
+			"Valid",
+			func(cfg *Config) {},
+			"",
+		},
+		{
+			"NoEndpoint",
+			func(cfg *Config) { cfg.Endpoint = "" },
+			"config.Endpoint must be specified",
+		},
+		{
+			"NoCollectionInterval",
+			func(cfg *Config) { cfg.CollectionInterval = 0 },
+			"config.CollectionInterval must be specified",
+		},
+		{
+			"TLSOnUnixEndpoint",
+			func(cfg *Config) {
+				cfg.TLS = configtls.TLSClientSetting{TLSSetting: configtls.TLSSetting{CAFile: "ca.pem"}}
+			},
+			"config.TLS must not be specified",
+		},
+		{
+			"TLSOnTCPEndpoint",
+			func(cfg *Config) {
+				cfg.Endpoint = "tcp://podman.example.com:2376"
+				cfg.TLS = configtls.TLSClientSetting{TLSSetting: configtls.TLSSetting{CAFile: "ca.pem"}}
+			},
+			"",
+		},
+		{
+			"EventsRefreshIntervalWithoutEventsMode",
+			func(cfg *Config) { cfg.EventsRefreshInterval = time.Minute },
+			"config.EventsRefreshInterval must not be specified",
+		},
+		{
+			"NegativeEventsRefreshInterval",
+			func(cfg *Config) {
+				cfg.Mode = modeEvents
+				cfg.EventsRefreshInterval = -time.Minute
+			},
+			"config.EventsRefreshInterval must be positive",
+		},
+		{
+			"ValidEventsMode",
+			func(cfg *Config) {
+				cfg.Mode = modeEvents
+				cfg.EventsRefreshInterval = time.Minute
+			},
+			"",
+		},
+		{
+			"InvalidMode",
+			func(cfg *Config) { cfg.Mode = "watch" },
+			"config.Mode must be either",
+		},
+		{
+			"PodLabelsWithoutCollectPods",
+			func(cfg *Config) { cfg.PodLabelsAsAttributes = []string{"app"} },
+			"config.PodLabelsAsAttributes must not be specified",
+		},
+		{
+			"ValidCollectPods",
+			func(cfg *Config) {
+				cfg.CollectPods = true
+				cfg.PodLabelsAsAttributes = []string{"app"}
+			},
+			"",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := validConfig()
+			tc.modify(cfg)
+			err := cfg.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.expectedErr)
+		})
+	}
+}
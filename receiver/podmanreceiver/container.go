@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package podmanreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/podmanreceiver"
+
+import "context"
+
+// containerStats is the subset of a /containers/stats response this
+// receiver turns into metrics.
+type containerStats struct {
+	ContainerID string  `json:"ContainerID"`
+	Name        string  `json:"Name"`
+	PodID       string  `json:"PodID"`
+	CPU         float64 `json:"CPU"`
+	MemUsage    uint64  `json:"MemUsage"`
+	NetInput    uint64  `json:"NetInput"`
+	NetOutput   uint64  `json:"NetOutput"`
+}
+
+type containerStatsResponse struct {
+	Stats []containerStats `json:"Stats"`
+}
+
+type containerSummary struct {
+	ID string `json:"Id"`
+}
+
+// listContainers returns the ids of every running container known to the
+// Podman API.
+func (c *podmanClient) listContainers(ctx context.Context) ([]string, error) {
+	var summaries []containerSummary
+	if err := c.get(ctx, "/containers/json", &summaries); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(summaries))
+	for _, s := range summaries {
+		ids = append(ids, s.ID)
+	}
+	return ids, nil
+}
+
+// containerStats returns stats for the given container ids in a single
+// request.
+func (c *podmanClient) containerStats(ctx context.Context, ids []string) ([]containerStats, error) {
+	path := "/containers/stats?stream=false"
+	for _, id := range ids {
+		path += "&containers=" + id
+	}
+
+	var resp containerStatsResponse
+	if err := c.get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Stats, nil
+}
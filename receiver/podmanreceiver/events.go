@@ -0,0 +1,162 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package podmanreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/podmanreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	initialEventBackoff = time.Second
+	maxEventBackoff     = 30 * time.Second
+)
+
+// containerEvent is the subset of a Podman /events payload the watcher
+// cares about.
+type containerEvent struct {
+	Type   string `json:"Type"`
+	Status string `json:"Status"`
+	Actor  struct {
+		ID string `json:"ID"`
+	} `json:"Actor"`
+}
+
+// eventWatcher maintains the set of running container ids for Config.Mode
+// "events" by consuming Podman's /events stream, reconnecting with
+// exponential backoff when the stream drops, and periodically reconciling
+// against the list API on refreshInterval in case an event was missed.
+type eventWatcher struct {
+	client          *podmanClient
+	refreshInterval time.Duration
+	logger          *zap.Logger
+
+	mu      sync.RWMutex
+	running map[string]struct{}
+}
+
+func newEventWatcher(client *podmanClient, refreshInterval time.Duration, logger *zap.Logger) *eventWatcher {
+	return &eventWatcher{
+		client:          client,
+		refreshInterval: refreshInterval,
+		logger:          logger,
+		running:         make(map[string]struct{}),
+	}
+}
+
+// run blocks until ctx is canceled, watching events in the background and
+// reconciling the running set on refreshInterval.
+func (w *eventWatcher) run(ctx context.Context) {
+	go w.watchLoop(ctx)
+
+	if err := w.reconcile(ctx); err != nil {
+		w.logger.Warn("initial container reconciliation failed", zap.Error(err))
+	}
+
+	ticker := time.NewTicker(w.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.reconcile(ctx); err != nil {
+				w.logger.Warn("container reconciliation failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// watchLoop consumes the /events stream, reconnecting with exponential
+// backoff whenever the connection drops, until ctx is canceled.
+func (w *eventWatcher) watchLoop(ctx context.Context) {
+	backoff := initialEventBackoff
+	for ctx.Err() == nil {
+		err := w.watchStream(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		w.logger.Warn("podman event stream disconnected, reconnecting",
+			zap.Error(err), zap.Duration("backoff", backoff))
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return
+		}
+
+		backoff *= 2
+		if backoff > maxEventBackoff {
+			backoff = maxEventBackoff
+		}
+	}
+}
+
+func (w *eventWatcher) watchStream(ctx context.Context) error {
+	stream, err := w.client.openStream(ctx, "/events?stream=true")
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	decoder := json.NewDecoder(stream)
+	for {
+		var ev containerEvent
+		if err := decoder.Decode(&ev); err != nil {
+			return err
+		}
+		if ev.Type != "container" {
+			continue
+		}
+		w.apply(ev)
+	}
+}
+
+func (w *eventWatcher) apply(ev containerEvent) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	switch ev.Status {
+	case "start":
+		w.running[ev.Actor.ID] = struct{}{}
+	case "died", "stop", "remove":
+		delete(w.running, ev.Actor.ID)
+	}
+}
+
+// reconcile replaces the running set with a fresh listing from the API.
+func (w *eventWatcher) reconcile(ctx context.Context) error {
+	ids, err := w.client.listContainers(ctx)
+	if err != nil {
+		return err
+	}
+
+	set := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+
+	w.mu.Lock()
+	w.running = set
+	w.mu.Unlock()
+	return nil
+}
+
+// runningIDs returns a snapshot of the currently running container ids.
+func (w *eventWatcher) runningIDs() []string {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	ids := make([]string, 0, len(w.running))
+	for id := range w.running {
+		ids = append(ids, id)
+	}
+	return ids
+}
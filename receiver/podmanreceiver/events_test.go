@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package podmanreceiver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+// TestEventWatcherAppliesStartAndStop verifies that start/died events
+// update the running set without requiring a reconcile.
+func TestEventWatcherAppliesStartAndStop(t *testing.T) {
+	events := []string{
+		`{"Type":"container","Status":"start","Actor":{"ID":"c1"}}`,
+		`{"Type":"container","Status":"start","Actor":{"ID":"c2"}}`,
+		`{"Type":"container","Status":"died","Actor":{"ID":"c1"}}`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v4.0.0/libpod/events":
+			flusher := w.(http.Flusher)
+			for _, ev := range events {
+				fmt.Fprintln(w, ev)
+				flusher.Flush()
+			}
+			<-r.Context().Done()
+		case "/v4.0.0/libpod/containers/json":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`[]`))
+		}
+	}))
+	defer server.Close()
+
+	cfg := &Config{Endpoint: "tcp://" + server.Listener.Addr().String(), Timeout: 5 * time.Second}
+	client, err := newPodmanClient(cfg)
+	require.NoError(t, err)
+
+	watcher := newEventWatcher(client, time.Hour, zaptest.NewLogger(t))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go watcher.watchLoop(ctx)
+
+	require.Eventually(t, func() bool {
+		ids := watcher.runningIDs()
+		return len(ids) == 1 && ids[0] == "c2"
+	}, time.Second, 10*time.Millisecond)
+}
+
+// TestEventWatcherReconnectsWithBackoff verifies that a dropped stream is
+// retried rather than abandoned.
+func TestEventWatcherReconnectsWithBackoff(t *testing.T) {
+	var connects int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v4.0.0/libpod/events" {
+			return
+		}
+		atomic.AddInt32(&connects, 1)
+		// Close the connection immediately to simulate a dropped stream.
+	}))
+	defer server.Close()
+
+	cfg := &Config{Endpoint: "tcp://" + server.Listener.Addr().String(), Timeout: 5 * time.Second}
+	client, err := newPodmanClient(cfg)
+	require.NoError(t, err)
+
+	watcher := newEventWatcher(client, time.Hour, zaptest.NewLogger(t))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go watcher.watchLoop(ctx)
+
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&connects) >= 2
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+// TestEventWatcherReconcile verifies the refresh-interval fallback replaces
+// the running set from a fresh API listing.
+func TestEventWatcherReconcile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v4.0.0/libpod/containers/json" {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`[{"Id":"c3"}]`))
+		}
+	}))
+	defer server.Close()
+
+	cfg := &Config{Endpoint: "tcp://" + server.Listener.Addr().String(), Timeout: 5 * time.Second}
+	client, err := newPodmanClient(cfg)
+	require.NoError(t, err)
+
+	watcher := newEventWatcher(client, time.Hour, zaptest.NewLogger(t))
+	require.NoError(t, watcher.reconcile(context.Background()))
+	require.Equal(t, []string{"c3"}, watcher.runningIDs())
+}
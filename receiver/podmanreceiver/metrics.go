@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package podmanreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/podmanreceiver"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// appendContainerResourceMetrics adds a ResourceMetrics for stat, identified
+// by podman.container.id/name resource attributes. Containers that belong
+// to a pod also get a podman.pod.id resource attribute so downstream
+// processors can join container- and pod-level metrics.
+func appendContainerResourceMetrics(md pmetric.Metrics, stat containerStats, now pcommon.Timestamp) {
+	rm := md.ResourceMetrics().AppendEmpty()
+	attrs := rm.Resource().Attributes()
+	attrs.PutStr("podman.container.id", stat.ContainerID)
+	attrs.PutStr("podman.container.name", stat.Name)
+	if stat.PodID != "" {
+		attrs.PutStr("podman.pod.id", stat.PodID)
+	}
+
+	metrics := rm.ScopeMetrics().AppendEmpty().Metrics()
+	addGauge(metrics, "podman.container.cpu.utilization", "1", stat.CPU, now)
+	addGauge(metrics, "podman.container.memory.usage", "By", float64(stat.MemUsage), now)
+	addGauge(metrics, "podman.container.network.io.input", "By", float64(stat.NetInput), now)
+	addGauge(metrics, "podman.container.network.io.output", "By", float64(stat.NetOutput), now)
+}
+
+// appendPodResourceMetrics adds a ResourceMetrics for stat, identified by
+// podman.pod.id/name resource attributes, with labelKeys from the pod's
+// labels attached directly as resource attributes.
+func appendPodResourceMetrics(md pmetric.Metrics, stat podStats, labels map[string]string, labelKeys []string, now pcommon.Timestamp) {
+	rm := md.ResourceMetrics().AppendEmpty()
+	attrs := rm.Resource().Attributes()
+	attrs.PutStr("podman.pod.id", stat.PodID)
+	attrs.PutStr("podman.pod.name", stat.Name)
+	for _, key := range labelKeys {
+		if v, ok := labels[key]; ok {
+			attrs.PutStr(key, v)
+		}
+	}
+
+	metrics := rm.ScopeMetrics().AppendEmpty().Metrics()
+	addGauge(metrics, "podman.pod.cpu.utilization", "1", stat.CPU, now)
+	addGauge(metrics, "podman.pod.memory.usage", "By", float64(stat.MemUsage), now)
+	addGauge(metrics, "podman.pod.network.io.input", "By", float64(stat.NetInput), now)
+	addGauge(metrics, "podman.pod.network.io.output", "By", float64(stat.NetOutput), now)
+}
+
+func addGauge(metrics pmetric.MetricSlice, name, unit string, value float64, now pcommon.Timestamp) {
+	m := metrics.AppendEmpty()
+	m.SetName(name)
+	m.SetUnit(unit)
+	dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(now)
+	dp.SetDoubleValue(value)
+}
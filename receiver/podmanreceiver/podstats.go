@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package podmanreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/podmanreceiver"
+
+import "context"
+
+// podStats is the subset of a /pods/stats response this receiver turns
+// into podman.pod.* metrics.
+type podStats struct {
+	PodID     string  `json:"PodId"`
+	Name      string  `json:"Name"`
+	CPU       float64 `json:"CPU"`
+	MemUsage  uint64  `json:"MemUsage"`
+	NetInput  uint64  `json:"NetInput"`
+	NetOutput uint64  `json:"NetOutput"`
+}
+
+type podInspect struct {
+	Labels map[string]string `json:"Labels"`
+}
+
+// podStats returns aggregated stats, across each pod's infra and workload
+// containers, for every pod known to the Podman API.
+func (c *podmanClient) podStats(ctx context.Context) ([]podStats, error) {
+	var stats []podStats
+	if err := c.get(ctx, "/pods/stats?stream=false", &stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// podLabels returns the labels configured on the given pod.
+func (c *podmanClient) podLabels(ctx context.Context, podID string) (map[string]string, error) {
+	var inspect podInspect
+	if err := c.get(ctx, "/pods/"+podID+"/json", &inspect); err != nil {
+		return nil, err
+	}
+	return inspect.Labels, nil
+}
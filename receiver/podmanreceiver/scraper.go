@@ -0,0 +1,116 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package podmanreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/podmanreceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
+)
+
+// podmanScraper pulls container metrics from the Podman API on every call
+// to scrape. In Config.Mode "events" it also runs an eventWatcher in the
+// background so scrape only has to ask for the current running set instead
+// of hitting the list API on every tick.
+type podmanScraper struct {
+	cfg    *Config
+	client *podmanClient
+	logger *zap.Logger
+	events *eventWatcher
+}
+
+func newPodmanScraper(cfg *Config, logger *zap.Logger) (*podmanScraper, error) {
+	client, err := newPodmanClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &podmanScraper{
+		cfg:    cfg,
+		client: client,
+		logger: logger,
+	}
+	if cfg.Mode == modeEvents {
+		refreshInterval := cfg.EventsRefreshInterval
+		if refreshInterval == 0 {
+			refreshInterval = 5 * time.Minute
+		}
+		s.events = newEventWatcher(client, refreshInterval, logger)
+	}
+	return s, nil
+}
+
+// start launches the background event watcher, if Config.Mode is "events".
+// It returns immediately; the watcher runs until ctx is canceled.
+func (s *podmanScraper) start(ctx context.Context) {
+	if s.events != nil {
+		go s.events.run(ctx)
+	}
+}
+
+func (s *podmanScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	md := pmetric.NewMetrics()
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	var scrapeErrs scrapererror.ScrapeErrors
+
+	ids, err := s.containerIDs(ctx)
+	if err != nil {
+		return md, err
+	}
+
+	if len(ids) > 0 {
+		stats, err := s.client.containerStats(ctx, ids)
+		if err != nil {
+			scrapeErrs.AddPartial(len(ids), err)
+		} else {
+			for _, stat := range stats {
+				appendContainerResourceMetrics(md, stat, now)
+			}
+		}
+	}
+
+	if s.cfg.CollectPods {
+		if err := s.scrapePods(ctx, md, now); err != nil {
+			scrapeErrs.AddPartial(1, err)
+		}
+	}
+
+	return md, scrapeErrs.Combine()
+}
+
+// scrapePods appends podman.pod.* resource metrics for every pod known to
+// the Podman API, attaching any of Config.PodLabelsAsAttributes found on
+// each pod.
+func (s *podmanScraper) scrapePods(ctx context.Context, md pmetric.Metrics, now pcommon.Timestamp) error {
+	stats, err := s.client.podStats(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, stat := range stats {
+		var labels map[string]string
+		if len(s.cfg.PodLabelsAsAttributes) > 0 {
+			labels, err = s.client.podLabels(ctx, stat.PodID)
+			if err != nil {
+				s.logger.Warn("failed to fetch pod labels", zap.String("pod", stat.PodID), zap.Error(err))
+			}
+		}
+		appendPodResourceMetrics(md, stat, labels, s.cfg.PodLabelsAsAttributes, now)
+	}
+	return nil
+}
+
+// containerIDs returns the ids to scrape stats for: the event watcher's
+// running set in "events" mode, or a fresh API listing in "poll" mode.
+func (s *podmanScraper) containerIDs(ctx context.Context) ([]string, error) {
+	if s.events != nil {
+		return s.events.runningIDs(), nil
+	}
+	return s.client.listContainers(ctx)
+}
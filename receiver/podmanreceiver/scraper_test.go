@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package podmanreceiver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestScraperCollectsContainerAndPodMetrics(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4.0.0/libpod/containers/json", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"Id":"c1"}]`))
+	})
+	mux.HandleFunc("/v4.0.0/libpod/containers/stats", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Stats":[{"ContainerID":"c1","Name":"web","PodID":"p1","CPU":0.5,"MemUsage":1024}]}`))
+	})
+	mux.HandleFunc("/v4.0.0/libpod/pods/stats", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"PodId":"p1","Name":"web-pod","CPU":0.7,"MemUsage":2048}]`))
+	})
+	mux.HandleFunc("/v4.0.0/libpod/pods/p1/json", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Labels":{"app":"web","env":"prod"}}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	cfg := &Config{
+		Endpoint:              "tcp://" + server.Listener.Addr().String(),
+		Timeout:               5 * time.Second,
+		CollectPods:           true,
+		PodLabelsAsAttributes: []string{"app"},
+	}
+
+	scraper, err := newPodmanScraper(cfg, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+
+	containerRM := md.ResourceMetrics().At(0)
+	containerID, ok := containerRM.Resource().Attributes().Get("podman.container.id")
+	require.True(t, ok)
+	require.Equal(t, "c1", containerID.Str())
+	podID, ok := containerRM.Resource().Attributes().Get("podman.pod.id")
+	require.True(t, ok)
+	require.Equal(t, "p1", podID.Str())
+
+	podRM := md.ResourceMetrics().At(1)
+	podName, ok := podRM.Resource().Attributes().Get("podman.pod.name")
+	require.True(t, ok)
+	require.Equal(t, "web-pod", podName.Str())
+	appLabel, ok := podRM.Resource().Attributes().Get("app")
+	require.True(t, ok)
+	require.Equal(t, "web", appLabel.Str())
+	_, ok = podRM.Resource().Attributes().Get("env")
+	require.False(t, ok, "only configured PodLabelsAsAttributes should be attached")
+}
+
+func TestScraperSkipsPodsWhenDisabled(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4.0.0/libpod/containers/json", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	})
+	mux.HandleFunc("/v4.0.0/libpod/pods/stats", func(w http.ResponseWriter, _ *http.Request) {
+		t.Fatal("pods/stats should not be called when CollectPods is false")
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	cfg := &Config{Endpoint: "tcp://" + server.Listener.Addr().String(), Timeout: 5 * time.Second}
+	scraper, err := newPodmanScraper(cfg, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 0, md.ResourceMetrics().Len())
+}